@@ -0,0 +1,133 @@
+package citrixitm
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/cedexis/go-itm/itm"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCitrixITMDnsApp() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCitrixITMDnsAppRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"app_id"},
+			},
+			"include_app_data": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"app_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"fallback_cname": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fallback_ttl": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCitrixITMDnsAppRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*itm.Client)
+	var app *itm.DNSApp
+	if id, ok := d.GetOk("app_id"); ok {
+		log.Printf("[INFO] Reading %s with ID %d", resourceName, id.(int))
+		gotten, err := client.DNSApps.Get(id.(int))
+		if err != nil {
+			return fmt.Errorf("Error reading %s with ID %d: %s", resourceName, id.(int), err)
+		}
+		app = gotten
+	} else if name, ok := d.GetOk("name"); ok {
+		log.Printf("[INFO] Looking up %s named %q", resourceName, name.(string))
+		found, err := findDNSAppByName(client, name.(string))
+		if err != nil {
+			return err
+		}
+		app = found
+	} else {
+		return fmt.Errorf("One of app_id or name must be set")
+	}
+	d.SetId(strconv.Itoa(app.Id))
+	d.Set("app_id", app.Id)
+	d.Set("name", app.Name)
+	d.Set("description", app.Description)
+	d.Set("enabled", app.Enabled)
+	d.Set("fallback_cname", app.FallbackCname)
+	d.Set("fallback_ttl", app.FallbackTtl)
+	d.Set("cname", app.AppCname)
+	d.Set("version", app.Version)
+	if d.Get("include_app_data").(bool) {
+		d.Set("app_data", app.AppData)
+	} else {
+		d.Set("app_data", "")
+	}
+	log.Printf("[INFO] Read %s with ID %s", resourceName, d.Id())
+	return nil
+}
+
+// findDNSAppByName returns the app with the given name. Deleting an app in
+// the Portal only disables it, so when several apps share the name the enabled
+// one is preferred.
+func findDNSAppByName(client *itm.Client, name string) (*itm.DNSApp, error) {
+	apps, err := client.DNSApps.List(func(app *itm.DNSApp) bool {
+		return app.Name == name
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing %ss: %s", resourceName, err)
+	}
+	switch len(apps) {
+	case 0:
+		return nil, fmt.Errorf("No %s named %q was found", resourceName, name)
+	case 1:
+		return &apps[0], nil
+	}
+	var enabled []itm.DNSApp
+	for _, current := range apps {
+		if current.Enabled {
+			enabled = append(enabled, current)
+		}
+	}
+	switch len(enabled) {
+	case 0:
+		return nil, fmt.Errorf("Found %d disabled %ss named %q; use app_id to select one", len(apps), resourceName, name)
+	case 1:
+		return &enabled[0], nil
+	}
+	return nil, fmt.Errorf("Found %d enabled %ss named %q; use app_id to select one", len(enabled), resourceName, name)
+}
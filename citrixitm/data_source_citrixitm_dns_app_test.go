@@ -0,0 +1,203 @@
+package citrixitm
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cedexis/go-itm/itm"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var testDnsApps = []itm.DNSApp{
+	{
+		Id:            1,
+		Name:          "Foo",
+		Description:   "Deleted foo",
+		Enabled:       false,
+		FallbackCname: "old.foo.com",
+		FallbackTtl:   20,
+		AppData:       "Old foo app data",
+		AppCname:      "2-01-abcd-0001.cdx.cedexis.net",
+		Version:       3,
+	},
+	{
+		Id:            2,
+		Name:          "Foo",
+		Description:   "Foo description",
+		Enabled:       true,
+		FallbackCname: "fallback.foo.com",
+		FallbackTtl:   30,
+		AppData:       "Foo app data",
+		AppCname:      "2-01-abcd-0002.cdx.cedexis.net",
+		Version:       7,
+	},
+	{
+		Id:            3,
+		Name:          "Bar",
+		Description:   "Bar description",
+		Enabled:       true,
+		FallbackCname: "fallback.bar.com",
+		FallbackTtl:   20,
+		AppData:       "Bar app data",
+		AppCname:      "2-01-abcd-0003.cdx.cedexis.net",
+		Version:       1,
+	},
+	{
+		Id:      4,
+		Name:    "Baz",
+		Enabled: true,
+	},
+	{
+		Id:      5,
+		Name:    "Baz",
+		Enabled: true,
+	},
+}
+
+// newTestDnsAppsClient returns an ITM client backed by a stand-in server that
+// serves the given apps from the DNS app list and get endpoints.
+func newTestDnsAppsClient(t *testing.T, apps []itm.DNSApp) (*itm.Client, *httptest.Server) {
+	mux := http.NewServeMux()
+	mux.Handle("/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var result interface{} = apps
+		if strings.HasPrefix(r.URL.Path, "/v2/config/applications/dns.json/") {
+			id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/v2/config/applications/dns.json/"))
+			result = nil
+			for _, current := range apps {
+				if current.Id == id {
+					result = current
+				}
+			}
+			if result == nil {
+				http.NotFound(w, r)
+				return
+			}
+		}
+		js, err := json.Marshal(result)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(js)
+	}))
+	server := httptest.NewServer(mux)
+	baseURL, _ := url.Parse(server.URL)
+	client, err := itm.NewClient(
+		itm.HTTPClient(server.Client()),
+		itm.BaseURL(baseURL),
+	)
+	if err != nil {
+		server.Close()
+		t.Fatalf("Got error: %#v", err)
+	}
+	return client, server
+}
+
+func TestDnsAppDataSourceRead(t *testing.T) {
+	client, server := newTestDnsAppsClient(t, testDnsApps)
+	defer server.Close()
+
+	testData := []struct {
+		raw             map[string]interface{}
+		expectedID      string
+		expectedAppData string
+	}{
+		{
+			map[string]interface{}{"app_id": 3},
+			"3",
+			"",
+		},
+		{
+			map[string]interface{}{"app_id": 1, "include_app_data": true},
+			"1",
+			"Old foo app data",
+		},
+		{
+			map[string]interface{}{"name": "Bar"},
+			"3",
+			"",
+		},
+		{
+			// The disabled app with the same name is skipped
+			map[string]interface{}{"name": "Foo", "include_app_data": true},
+			"2",
+			"Foo app data",
+		},
+	}
+	dataSource := dataSourceCitrixITMDnsApp()
+	for _, current := range testData {
+		data := schema.TestResourceDataRaw(t, dataSource.Schema, current.raw)
+		if err := dataSource.Read(data, client); err != nil {
+			t.Errorf("Got error reading data source %v: %s", current.raw, err)
+			continue
+		}
+		if data.Id() != current.expectedID {
+			t.Error(unexpectedValueString("ID", current.expectedID, data.Id()))
+		}
+		if got := data.Get("app_data").(string); got != current.expectedAppData {
+			t.Error(unexpectedValueString("app data", current.expectedAppData, got))
+		}
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name": "Foo"})
+	if err := dataSource.Read(data, client); err != nil {
+		t.Fatalf("Got error reading data source: %s", err)
+	}
+	expected := map[string]interface{}{
+		"app_id":         2,
+		"description":    "Foo description",
+		"enabled":        true,
+		"fallback_cname": "fallback.foo.com",
+		"fallback_ttl":   30,
+		"cname":          "2-01-abcd-0002.cdx.cedexis.net",
+		"version":        7,
+	}
+	for key, want := range expected {
+		if got := data.Get(key); got != want {
+			t.Error(unexpectedValueString(key, want, got))
+		}
+	}
+}
+
+func TestDnsAppDataSourceReadErrors(t *testing.T) {
+	client, server := newTestDnsAppsClient(t, testDnsApps)
+	defer server.Close()
+
+	testData := []struct {
+		raw           map[string]interface{}
+		expectedError string
+	}{
+		{
+			map[string]interface{}{"app_id": 42},
+			"Error reading Citrix ITM DNS app with ID 42",
+		},
+		{
+			map[string]interface{}{"name": "Qux"},
+			`No Citrix ITM DNS app named "Qux" was found`,
+		},
+		{
+			map[string]interface{}{"name": "Baz"},
+			`Found 2 enabled Citrix ITM DNS apps named "Baz"`,
+		},
+		{
+			map[string]interface{}{},
+			"One of app_id or name must be set",
+		},
+	}
+	dataSource := dataSourceCitrixITMDnsApp()
+	for _, current := range testData {
+		data := schema.TestResourceDataRaw(t, dataSource.Schema, current.raw)
+		err := dataSource.Read(data, client)
+		if err == nil {
+			t.Errorf("Expected an error reading data source %v", current.raw)
+		} else if !strings.Contains(err.Error(), current.expectedError) {
+			t.Error(unexpectedValueString("error", current.expectedError, err.Error()))
+		}
+	}
+}
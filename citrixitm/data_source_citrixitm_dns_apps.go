@@ -0,0 +1,137 @@
+package citrixitm
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cedexis/go-itm/itm"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCitrixITMDnsApps() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCitrixITMDnsAppsRead,
+
+		Schema: map[string]*schema.Schema{
+			"description_contains": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateDNSAppsNameRegex,
+			},
+			"apps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"app_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"cname": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"enabled": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCitrixITMDnsAppsRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[INFO] Listing %ss", resourceName)
+	client := m.(*itm.Client)
+	var tests []func(*itm.DNSApp) bool
+	if v, ok := d.GetOk("name_regex"); ok {
+		// Validation is skipped for values that are unknown at plan time, so
+		// the expression is checked again here.
+		nameRegex, err := regexp.Compile(v.(string))
+		if err != nil {
+			return fmt.Errorf("Invalid name_regex %q: %s", v.(string), err)
+		}
+		tests = append(tests, func(app *itm.DNSApp) bool {
+			return nameRegex.MatchString(app.Name)
+		})
+	}
+	if v, ok := d.GetOkExists("enabled"); ok {
+		enabled := v.(bool)
+		tests = append(tests, func(app *itm.DNSApp) bool {
+			return app.Enabled == enabled
+		})
+	}
+	if v, ok := d.GetOk("description_contains"); ok {
+		substring := v.(string)
+		tests = append(tests, func(app *itm.DNSApp) bool {
+			return strings.Contains(app.Description, substring)
+		})
+	}
+	// DNSApps.List takes its filters as variadic arguments of an unexported
+	// type, so they are combined into a single function literal here.
+	apps, err := client.DNSApps.List(func(app *itm.DNSApp) bool {
+		for _, test := range tests {
+			if !test(app) {
+				return false
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return fmt.Errorf("Error listing %ss: %s", resourceName, err)
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].Id < apps[j].Id
+	})
+
+	result := make([]map[string]interface{}, len(apps))
+	ids := make([]string, len(apps))
+	for i, app := range apps {
+		result[i] = map[string]interface{}{
+			"app_id":      app.Id,
+			"cname":       app.AppCname,
+			"description": app.Description,
+			"enabled":     app.Enabled,
+			"name":        app.Name,
+			"version":     app.Version,
+		}
+		ids[i] = strconv.Itoa(app.Id)
+	}
+	log.Printf("[DEBUG] Found %d matching %ss", len(apps), resourceName)
+	d.SetId(strconv.Itoa(hashcode.String(strings.Join(ids, ","))))
+	return d.Set("apps", result)
+}
+
+func validateDNSAppsNameRegex(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
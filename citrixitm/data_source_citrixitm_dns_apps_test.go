@@ -0,0 +1,105 @@
+package citrixitm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cedexis/go-itm/itm"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func TestDnsAppsDataSourceRead(t *testing.T) {
+	// Serve the apps in reverse order to check that the result is sorted
+	reversed := make([]itm.DNSApp, len(testDnsApps))
+	for i, app := range testDnsApps {
+		reversed[len(testDnsApps)-1-i] = app
+	}
+	client, server := newTestDnsAppsClient(t, reversed)
+	defer server.Close()
+
+	testData := []struct {
+		raw         map[string]interface{}
+		expectedIDs []int
+	}{
+		{
+			map[string]interface{}{},
+			[]int{1, 2, 3, 4, 5},
+		},
+		{
+			map[string]interface{}{"enabled": false},
+			[]int{1},
+		},
+		{
+			map[string]interface{}{"name_regex": "^Ba"},
+			[]int{3, 4, 5},
+		},
+		{
+			map[string]interface{}{"description_contains": "description"},
+			[]int{2, 3},
+		},
+		{
+			map[string]interface{}{"enabled": true, "name_regex": "oo$"},
+			[]int{2},
+		},
+		{
+			map[string]interface{}{"name_regex": "^Qux$"},
+			[]int{},
+		},
+	}
+	dataSource := dataSourceCitrixITMDnsApps()
+	for _, current := range testData {
+		data := schema.TestResourceDataRaw(t, dataSource.Schema, current.raw)
+		if err := dataSource.Read(data, client); err != nil {
+			t.Errorf("Got error reading data source %v: %s", current.raw, err)
+			continue
+		}
+		gotIDs := []int{}
+		for _, app := range data.Get("apps").([]interface{}) {
+			gotIDs = append(gotIDs, app.(map[string]interface{})["app_id"].(int))
+		}
+		if !reflect.DeepEqual(current.expectedIDs, gotIDs) {
+			t.Error(unexpectedValueString("app IDs", current.expectedIDs, gotIDs))
+		}
+	}
+
+	data := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{"name_regex": "^Bar$"})
+	if err := dataSource.Read(data, client); err != nil {
+		t.Fatalf("Got error reading data source: %s", err)
+	}
+	expected := map[string]interface{}{
+		"apps.0.app_id":      3,
+		"apps.0.name":        "Bar",
+		"apps.0.description": "Bar description",
+		"apps.0.enabled":     true,
+		"apps.0.cname":       "2-01-abcd-0003.cdx.cedexis.net",
+		"apps.0.version":     1,
+	}
+	for key, want := range expected {
+		if got := data.Get(key); got != want {
+			t.Error(unexpectedValueString(key, want, got))
+		}
+	}
+}
+
+func TestDnsAppsDataSourceInvalidNameRegex(t *testing.T) {
+	client, server := newTestDnsAppsClient(t, testDnsApps)
+	defer server.Close()
+
+	dataSource := dataSourceCitrixITMDnsApps()
+	if _, errs := validateDNSAppsNameRegex("(", "name_regex"); len(errs) != 1 {
+		t.Errorf("Expected one validation error, got %v", errs)
+	}
+
+	// Read must report the error rather than panic, since validation does not
+	// run for values interpolated at apply time.
+	data := schema.TestResourceDataRaw(t, dataSource.Schema, map[string]interface{}{})
+	data.Set("name_regex", "(")
+	err := dataSource.Read(data, client)
+	if err == nil {
+		t.Fatal("Expected an error reading data source with an invalid name_regex")
+	}
+	if !strings.Contains(err.Error(), `Invalid name_regex "("`) {
+		t.Error(unexpectedValueString("error", `Invalid name_regex "("`, err.Error()))
+	}
+}
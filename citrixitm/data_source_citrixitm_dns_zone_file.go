@@ -0,0 +1,82 @@
+package citrixitm
+
+import (
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceCitrixITMDnsZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCitrixITMDnsZoneFileRead,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fqdn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"values": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCitrixITMDnsZoneFileRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[INFO] Parsing zone file")
+	content := d.Get("content").(string)
+	sets, origin, err := parseZoneFile(content, d.Get("origin").(string))
+	if err != nil {
+		return err
+	}
+	if err := formatZoneValidationErrors(validateZoneRecordSets(sets, origin)); err != nil {
+		return err
+	}
+	records := make([]map[string]interface{}, len(sets))
+	for i, set := range sets {
+		records[i] = map[string]interface{}{
+			"fqdn":   set.Name,
+			"name":   set.Relative(origin),
+			"ttl":    set.TTL,
+			"type":   set.Type,
+			"values": set.Values,
+		}
+	}
+	log.Printf("[DEBUG] Parsed %d record sets for origin %s", len(records), origin)
+	d.SetId(strconv.Itoa(hashcode.String(content)))
+	d.Set("origin", origin)
+	return d.Set("records", records)
+}
@@ -0,0 +1,43 @@
+package citrixitm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestDnsZoneFileDataSource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testDnsZoneFileDataSourceConfig(testZoneFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "origin", "example.com."),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.#", "10"),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.2.name", "@"),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.2.type", "MX"),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.8.name", "www"),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.8.fqdn", "www.example.com."),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.8.ttl", "300"),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.8.values.#", "2"),
+					resource.TestCheckResourceAttr("data.citrixitm_dns_zone_file.foo", "records.8.values.1", "192.0.2.2"),
+				),
+			},
+		},
+	})
+}
+
+func testDnsZoneFileDataSourceConfig(content string) string {
+	return fmt.Sprintf(`
+provider "citrixitm" {
+  client_id     = "foo"
+  client_secret = "bar"
+}
+
+data "citrixitm_dns_zone_file" "foo" {
+  content = <<EOF
+%sEOF
+}`, content)
+}
@@ -0,0 +1,72 @@
+package citrixitm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+const radarTagHost = "radar.cedexis.com"
+
+const radarTagAsyncLoaderTemplate = `<script>
+(function(w, d) {
+  var loader = function() {
+    var s = d.createElement('script'), tag = d.getElementsByTagName('script')[0];
+    s.src = '%s';
+    tag.parentNode.insertBefore(s, tag);
+  };
+  if (w.addEventListener) {
+    w.addEventListener('load', loader, false);
+  } else if (w.attachEvent) {
+    w.attachEvent('onload', loader);
+  }
+}(window, document));
+</script>`
+
+func dataSourceCitrixITMRadarTag() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCitrixITMRadarTagRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"customer_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"async_loader": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"html": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// The Radar tag is fully determined by the account and customer IDs, so the
+// snippets are built locally rather than fetched from the API.
+func dataSourceCitrixITMRadarTagRead(d *schema.ResourceData, m interface{}) error {
+	accountID := d.Get("account_id").(int)
+	customerID := d.Get("customer_id").(int)
+	log.Printf("[INFO] Reading Citrix ITM Radar tag for account %d and customer %d", accountID, customerID)
+	tagURL := radarTagURL(accountID, customerID)
+	d.SetId(fmt.Sprintf("%d-%d", accountID, customerID))
+	d.Set("url", tagURL)
+	d.Set("html", fmt.Sprintf(`<script src="%s" async></script>`, tagURL))
+	d.Set("async_loader", fmt.Sprintf(radarTagAsyncLoaderTemplate, tagURL))
+	return nil
+}
+
+func radarTagURL(accountID int, customerID int) string {
+	return fmt.Sprintf("https://%s/%d/%d/radar.js", radarTagHost, accountID, customerID)
+}
@@ -0,0 +1,38 @@
+package citrixitm
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestRadarTagDataSource(t *testing.T) {
+	resource.UnitTest(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testRadarTagDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.citrixitm_radar_tag.foo", "id", "1-12345"),
+					resource.TestCheckResourceAttr("data.citrixitm_radar_tag.foo", "url", "https://radar.cedexis.com/1/12345/radar.js"),
+					resource.TestCheckResourceAttr("data.citrixitm_radar_tag.foo", "html", `<script src="https://radar.cedexis.com/1/12345/radar.js" async></script>`),
+					resource.TestMatchResourceAttr("data.citrixitm_radar_tag.foo", "async_loader", regexp.MustCompile(`s\.src = 'https://radar\.cedexis\.com/1/12345/radar\.js';`)),
+				),
+			},
+		},
+	})
+}
+
+// The data source never calls the API, so placeholder credentials are enough
+// to configure the provider.
+const testRadarTagDataSourceConfig = `
+provider "citrixitm" {
+  client_id     = "foo"
+  client_secret = "bar"
+}
+
+data "citrixitm_radar_tag" "foo" {
+  account_id  = 1
+  customer_id = 12345
+}`
@@ -10,6 +10,13 @@ import (
 
 func Provider() terraform.ResourceProvider {
 	return &schema.Provider{
+		DataSourcesMap: map[string]*schema.Resource{
+			"citrixitm_dns_app":       dataSourceCitrixITMDnsApp(),
+			"citrixitm_dns_apps":      dataSourceCitrixITMDnsApps(),
+			"citrixitm_dns_zone_file": dataSourceCitrixITMDnsZoneFile(),
+			"citrixitm_radar_tag":     dataSourceCitrixITMRadarTag(),
+		},
+
 		ResourcesMap: map[string]*schema.Resource{
 			"citrixitm_dns_app": resourceCitrixITMDnsApp(),
 		},
@@ -0,0 +1,420 @@
+package citrixitm
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// zoneRecordSet is a group of records from a zone file sharing the same owner
+// name and type. Names are fully qualified, lower case and end with a dot.
+type zoneRecordSet struct {
+	Name   string
+	Type   string
+	TTL    int
+	Values []string
+}
+
+// Relative returns the record set's owner name relative to origin, using "@"
+// for the apex.
+func (r *zoneRecordSet) Relative(origin string) string {
+	if r.Name == origin {
+		return "@"
+	}
+	return strings.TrimSuffix(r.Name, "."+origin)
+}
+
+var zoneFileClasses = map[string]bool{
+	"IN": true,
+	"CH": true,
+	"CS": true,
+	"HS": true,
+}
+
+// Record types that may appear in a zone file. SOA is accepted but skipped,
+// because the SOA settings belong to the zone rather than to a record set.
+var zoneFileRecordTypes = map[string]bool{
+	"A":     true,
+	"AAAA":  true,
+	"CAA":   true,
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"SOA":   true,
+	"SRV":   true,
+	"TXT":   true,
+}
+
+type zoneFileEntry struct {
+	line   int
+	indent bool
+	tokens []string
+}
+
+// parseZoneFile parses the text of an RFC 1035 master file into record sets
+// sorted by name and type. origin is used until a $ORIGIN directive is seen
+// and may be empty if the file sets its own.
+func parseZoneFile(content string, origin string) ([]zoneRecordSet, string, error) {
+	entries, err := tokenizeZoneFile(content)
+	if err != nil {
+		return nil, "", err
+	}
+	if origin != "" {
+		origin = qualifyZoneName(origin, ".")
+	}
+	var (
+		zoneOrigin string
+		owner      string
+		defaultTTL = -1
+		lastTTL    = -1
+		sets       = map[string]*zoneRecordSet{}
+	)
+	for _, entry := range entries {
+		tokens := entry.tokens
+		if strings.HasPrefix(tokens[0], "$") && !entry.indent {
+			directive := strings.ToUpper(tokens[0])
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, "", fmt.Errorf("line %d: $ORIGIN takes exactly one domain name", entry.line)
+				}
+				origin = qualifyZoneName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, "", fmt.Errorf("line %d: $TTL takes exactly one value", entry.line)
+				}
+				ttl, ok := parseZoneFileTTL(tokens[1])
+				if !ok {
+					return nil, "", fmt.Errorf("line %d: invalid $TTL value %q; TTLs must not exceed %d seconds", entry.line, tokens[1], maxRecordTTL)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, "", fmt.Errorf("line %d: unsupported directive %s", entry.line, tokens[0])
+			}
+			continue
+		}
+		if origin == "" {
+			return nil, "", fmt.Errorf("line %d: no origin is set; add a $ORIGIN directive or set the origin argument", entry.line)
+		}
+		if zoneOrigin == "" {
+			zoneOrigin = origin
+		}
+		if !entry.indent {
+			owner = qualifyZoneName(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, "", fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+		if owner != zoneOrigin && !strings.HasSuffix(owner, "."+zoneOrigin) {
+			return nil, "", fmt.Errorf("line %d: name %s is outside of zone %s", entry.line, owner, zoneOrigin)
+		}
+
+		ttl := -1
+		var recordType string
+		for len(tokens) > 0 && recordType == "" {
+			token := strings.ToUpper(tokens[0])
+			if value, ok := parseZoneFileTTL(token); ok && ttl < 0 {
+				ttl = value
+			} else if ok {
+				return nil, "", fmt.Errorf("line %d: record for %s has more than one TTL", entry.line, owner)
+			} else if token[0] >= '0' && token[0] <= '9' {
+				return nil, "", fmt.Errorf("line %d: invalid TTL %s; TTLs must not exceed %d seconds", entry.line, tokens[0], maxRecordTTL)
+			} else if zoneFileClasses[token] {
+				if token != "IN" {
+					return nil, "", fmt.Errorf("line %d: unsupported class %s", entry.line, token)
+				}
+			} else if zoneFileRecordTypes[token] {
+				recordType = token
+			} else {
+				return nil, "", fmt.Errorf("line %d: unsupported record type %s", entry.line, tokens[0])
+			}
+			tokens = tokens[1:]
+		}
+		if recordType == "" {
+			return nil, "", fmt.Errorf("line %d: record for %s has no type", entry.line, owner)
+		}
+		if ttl < 0 {
+			ttl = defaultTTL
+		}
+		if ttl < 0 {
+			ttl = lastTTL
+		}
+		if ttl < 0 {
+			return nil, "", fmt.Errorf("line %d: record for %s has no TTL and no $TTL directive precedes it", entry.line, owner)
+		}
+		lastTTL = ttl
+		if recordType == "SOA" {
+			continue
+		}
+
+		value, err := parseZoneFileRData(recordType, tokens, origin)
+		if err != nil {
+			return nil, "", fmt.Errorf("line %d: %s record for %s: %s", entry.line, recordType, owner, err)
+		}
+		key := owner + " " + recordType
+		set, ok := sets[key]
+		if !ok {
+			set = &zoneRecordSet{
+				Name: owner,
+				Type: recordType,
+				TTL:  ttl,
+			}
+			sets[key] = set
+		} else if set.TTL != ttl {
+			return nil, "", fmt.Errorf("line %d: TTL %d for %s %s differs from TTL %d used earlier in the same record set", entry.line, ttl, owner, recordType, set.TTL)
+		}
+		set.Values = append(set.Values, value)
+	}
+
+	result := make([]zoneRecordSet, 0, len(sets))
+	for _, set := range sets {
+		result = append(result, *set)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].Type < result[j].Type
+	})
+	if zoneOrigin == "" {
+		zoneOrigin = origin
+	}
+	return result, zoneOrigin, nil
+}
+
+// tokenizeZoneFile splits zone file text into entries, one per logical line.
+// Comments are dropped, parentheses join physical lines and quoted strings
+// are kept as single tokens, including their quotes. Backslash escapes are
+// left in place so that an escaped character never acts as a delimiter.
+func tokenizeZoneFile(content string) ([]zoneFileEntry, error) {
+	var (
+		entries []zoneFileEntry
+		current zoneFileEntry
+		token   strings.Builder
+		line    = 1
+		depth   = 0
+		atStart = true
+	)
+	flushToken := func() {
+		if token.Len() > 0 {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+		}
+	}
+	flushEntry := func() {
+		flushToken()
+		if len(current.tokens) > 0 {
+			entries = append(entries, current)
+		}
+		current = zoneFileEntry{}
+		atStart = true
+	}
+	for i := 0; i < len(content); i++ {
+		c := content[i]
+		if atStart {
+			current.line = line
+			current.indent = c == ' ' || c == '\t'
+			atStart = false
+		}
+		switch c {
+		case '"':
+			start := line
+			token.WriteByte(c)
+			for i++; ; i++ {
+				if i >= len(content) {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", start)
+				}
+				c = content[i]
+				token.WriteByte(c)
+				if c == '\\' && i+1 < len(content) {
+					i++
+					token.WriteByte(content[i])
+				} else if c == '"' {
+					break
+				} else if c == '\n' {
+					line++
+				}
+			}
+		case '\\':
+			token.WriteByte(c)
+			if i+1 < len(content) {
+				i++
+				if content[i] == '\n' {
+					line++
+				}
+				token.WriteByte(content[i])
+			}
+		case ';':
+			for i < len(content) && content[i] != '\n' {
+				i++
+			}
+			i--
+		case '(':
+			flushToken()
+			depth++
+		case ')':
+			flushToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced closing parenthesis", line)
+			}
+			depth--
+		case '\n':
+			line++
+			if depth > 0 {
+				flushToken()
+			} else {
+				flushEntry()
+			}
+		case ' ', '\t', '\r':
+			flushToken()
+		default:
+			token.WriteByte(c)
+		}
+	}
+	if depth > 0 {
+		return nil, fmt.Errorf("line %d: unbalanced opening parenthesis", current.line)
+	}
+	flushEntry()
+	return entries, nil
+}
+
+// parseZoneFileRData checks the record data for the given type and returns it
+// in a normalized form, with domain names made fully qualified.
+func parseZoneFileRData(recordType string, tokens []string, origin string) (string, error) {
+	expect := func(n int) error {
+		if len(tokens) != n {
+			return fmt.Errorf("expected %d fields, got %d", n, len(tokens))
+		}
+		return nil
+	}
+	switch recordType {
+	case "A":
+		if err := expect(1); err != nil {
+			return "", err
+		}
+		// net.ParseIP also accepts IPv4-mapped IPv6 text such as ::ffff:192.0.2.1,
+		// so anything containing a colon is rejected up front.
+		if ip := net.ParseIP(tokens[0]); strings.Contains(tokens[0], ":") || ip == nil || ip.To4() == nil {
+			return "", fmt.Errorf("invalid IPv4 address %q", tokens[0])
+		}
+		return tokens[0], nil
+	case "AAAA":
+		if err := expect(1); err != nil {
+			return "", err
+		}
+		if ip := net.ParseIP(tokens[0]); ip == nil || ip.To4() != nil {
+			return "", fmt.Errorf("invalid IPv6 address %q", tokens[0])
+		}
+		return tokens[0], nil
+	case "CNAME", "NS":
+		if err := expect(1); err != nil {
+			return "", err
+		}
+		return qualifyZoneName(tokens[0], origin), nil
+	case "MX":
+		if err := expect(2); err != nil {
+			return "", err
+		}
+		if err := checkZoneFileUint("preference", tokens[0], 65535); err != nil {
+			return "", err
+		}
+		return tokens[0] + " " + qualifyZoneName(tokens[1], origin), nil
+	case "SRV":
+		if err := expect(4); err != nil {
+			return "", err
+		}
+		for i, label := range []string{"priority", "weight", "port"} {
+			if err := checkZoneFileUint(label, tokens[i], 65535); err != nil {
+				return "", err
+			}
+		}
+		return strings.Join(tokens[:3], " ") + " " + qualifyZoneName(tokens[3], origin), nil
+	case "CAA":
+		if err := expect(3); err != nil {
+			return "", err
+		}
+		if err := checkZoneFileUint("flags", tokens[0], 255); err != nil {
+			return "", err
+		}
+		return strings.Join(tokens, " "), nil
+	case "TXT":
+		if len(tokens) == 0 {
+			return "", fmt.Errorf("expected at least one string")
+		}
+		quoted := make([]string, len(tokens))
+		for i, current := range tokens {
+			if strings.HasPrefix(current, `"`) {
+				quoted[i] = current
+			} else {
+				// Unquoted strings use the same RFC 1035 escapes as quoted
+				// ones, so they only need to be wrapped.
+				quoted[i] = `"` + current + `"`
+			}
+		}
+		return strings.Join(quoted, " "), nil
+	}
+	return "", fmt.Errorf("unsupported record type")
+}
+
+// parseZoneFileTTL parses a TTL given in seconds or in BIND's unit notation,
+// for example "1h30m". Values above maxRecordTTL are rejected.
+func parseZoneFileTTL(value string) (int, bool) {
+	if value == "" {
+		return 0, false
+	}
+	units := map[byte]int64{'S': 1, 'M': 60, 'H': 3600, 'D': 86400, 'W': 604800}
+	var total, n int64
+	digits, unitSeen := 0, false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+			digits++
+			if n > maxRecordTTL {
+				return 0, false
+			}
+			continue
+		}
+		multiplier, ok := units[c&^0x20]
+		if !ok || digits == 0 {
+			return 0, false
+		}
+		total += n * multiplier
+		if total > maxRecordTTL {
+			return 0, false
+		}
+		n, digits, unitSeen = 0, 0, true
+	}
+	if digits > 0 {
+		if unitSeen {
+			return 0, false
+		}
+		total = n
+	}
+	return int(total), true
+}
+
+func checkZoneFileUint(label string, value string, max int) error {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 || n > max {
+		return fmt.Errorf("invalid %s %q", label, value)
+	}
+	return nil
+}
+
+// qualifyZoneName returns name as a lower case, fully qualified domain name,
+// resolving "@" and relative names against origin.
+func qualifyZoneName(name string, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	case origin == ".":
+		return name + "."
+	}
+	return name + "." + origin
+}
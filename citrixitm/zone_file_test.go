@@ -0,0 +1,176 @@
+package citrixitm
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2019020401 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		300 )      ; minimum
+	IN	NS	ns1
+	IN	NS	ns2.example.net.
+	IN	MX	10 mail
+	IN	TXT	"v=spf1 mx -all"
+www	300	IN	A	192.0.2.1
+	300	IN	A	192.0.2.2
+	IN	300	AAAA	2001:db8::1
+mail		A	192.0.2.25
+_sip._tcp	SRV	10 60 5060 sip
+txt		TXT	foo\032bar "quoted\;semi" unquoted\;semi ; comment
+		TXT	escaped\ space\001
+$ORIGIN sub.example.com.
+api		CNAME	www.example.com.
+@		CAA	0 issue "letsencrypt.org"
+`
+
+func TestParseZoneFile(t *testing.T) {
+	sets, origin, err := parseZoneFile(testZoneFile, "")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if origin != "example.com." {
+		t.Error(unexpectedValueString("origin", "example.com.", origin))
+	}
+	expected := []zoneRecordSet{
+		{"_sip._tcp.example.com.", "SRV", 3600, []string{"10 60 5060 sip.example.com."}},
+		{"api.sub.example.com.", "CNAME", 3600, []string{"www.example.com."}},
+		{"example.com.", "MX", 3600, []string{"10 mail.example.com."}},
+		{"example.com.", "NS", 3600, []string{"ns1.example.com.", "ns2.example.net."}},
+		{"example.com.", "TXT", 3600, []string{`"v=spf1 mx -all"`}},
+		{"mail.example.com.", "A", 3600, []string{"192.0.2.25"}},
+		{"sub.example.com.", "CAA", 3600, []string{`0 issue "letsencrypt.org"`}},
+		{"txt.example.com.", "TXT", 3600, []string{`"foo\032bar" "quoted\;semi" "unquoted\;semi"`, `"escaped\ space\001"`}},
+		{"www.example.com.", "A", 300, []string{"192.0.2.1", "192.0.2.2"}},
+		{"www.example.com.", "AAAA", 300, []string{"2001:db8::1"}},
+	}
+	if !reflect.DeepEqual(expected, sets) {
+		t.Error(unexpectedValueString("record sets", expected, sets))
+	}
+}
+
+func TestParseZoneFileOriginArgument(t *testing.T) {
+	sets, origin, err := parseZoneFile("www 60 A 192.0.2.1\n", "Example.com")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+	if origin != "example.com." {
+		t.Error(unexpectedValueString("origin", "example.com.", origin))
+	}
+	if len(sets) != 1 || sets[0].Relative(origin) != "www" {
+		t.Errorf("Unexpected record sets: %#v", sets)
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	testData := []struct {
+		content       string
+		expectedError string
+	}{
+		{
+			"www 60 A 192.0.2.1\n",
+			"line 1: no origin is set",
+		},
+		{
+			"$ORIGIN example.com.\nwww A 192.0.2.1\n",
+			"line 2: record for www.example.com. has no TTL",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\n\nwww IN PTR foo\n",
+			"line 4: unsupported record type PTR",
+		},
+		{
+			"$ORIGIN example.com.\n$INCLUDE other.zone\n",
+			"line 2: unsupported directive $INCLUDE",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\nwww CH A 192.0.2.1\n",
+			"line 3: unsupported class CH",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\nwww A 192.0.2\n",
+			"line 3: A record for www.example.com.: invalid IPv4 address",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\nwww A ::ffff:192.0.2.1\n",
+			"line 3: A record for www.example.com.: invalid IPv4 address",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\n@ MX mail\n",
+			"line 3: MX record for example.com.: expected 2 fields, got 1",
+		},
+		{
+			"$ORIGIN example.com.\nwww 60 A 192.0.2.1\nwww 120 A 192.0.2.2\n",
+			"line 3: TTL 120 for www.example.com. A differs",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\nwww.example.net. A 192.0.2.1\n",
+			"line 3: name www.example.net. is outside of zone example.com.",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\nwww 4294967295 A 192.0.2.1\n",
+			"line 3: invalid TTL 4294967295; TTLs must not exceed 2147483647 seconds",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\nwww 9223372036854775807m A 192.0.2.1\n",
+			"line 3: invalid TTL 9223372036854775807m",
+		},
+		{
+			"$ORIGIN example.com.\nwww 60 300 A 192.0.2.1\n",
+			"line 2: record for www.example.com. has more than one TTL",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 2147483648\n",
+			"line 2: invalid $TTL value \"2147483648\"",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\n@ TXT ( \"foo\"\n",
+			"unbalanced opening parenthesis",
+		},
+		{
+			"$ORIGIN example.com.\n$TTL 60\n@ TXT \"foo\n",
+			"line 3: unterminated quoted string",
+		},
+	}
+	for _, current := range testData {
+		_, _, err := parseZoneFile(current.content, "")
+		if err == nil {
+			t.Errorf("Expected an error parsing %q", current.content)
+		} else if !strings.Contains(err.Error(), current.expectedError) {
+			t.Error(unexpectedValueString("error", current.expectedError, err.Error()))
+		}
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	testData := []struct {
+		value    string
+		expected int
+		ok       bool
+	}{
+		{"3600", 3600, true},
+		{"1h30m", 5400, true},
+		{"1W2d", 777600, true},
+		{"10", 10, true},
+		{"h", 0, false},
+		{"1x", 0, false},
+		{"30m5", 0, false},
+		{"2147483647", 2147483647, true},
+		{"2147483648", 0, false},
+		{"35792w", 0, false},
+		{"9223372036854775807m", 0, false},
+		{"99999999999999999999", 0, false},
+	}
+	for _, current := range testData {
+		got, ok := parseZoneFileTTL(current.value)
+		if ok != current.ok || got != current.expected {
+			t.Error(unexpectedValueString("TTL "+current.value, current.expected, got))
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package citrixitm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	// RFC 2181 section 8 limits TTLs to unsigned 31 bit values. The zone file
+	// parser enforces this while reading TTLs.
+	maxRecordTTL = 2147483647
+
+	// A TXT character-string is prefixed by a single length byte.
+	maxTXTStringLength = 255
+)
+
+// validateZoneRecordSets checks record sets for mistakes that either the API
+// would reject or that would produce a broken zone. Every problem found is
+// returned, so that they can all be fixed in one pass.
+func validateZoneRecordSets(sets []zoneRecordSet, origin string) []error {
+	var errs []error
+	typesByName := map[string][]string{}
+	for _, set := range sets {
+		typesByName[set.Name] = append(typesByName[set.Name], set.Type)
+	}
+	hasCNAME := func(name string) bool {
+		for _, current := range typesByName[name] {
+			if current == "CNAME" {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, set := range sets {
+		switch set.Type {
+		case "CNAME":
+			if set.Name == origin {
+				errs = append(errs, fmt.Errorf("%s CNAME: a CNAME record is not allowed at the zone apex", set.Name))
+			}
+			if len(set.Values) > 1 {
+				errs = append(errs, fmt.Errorf("%s CNAME: only one CNAME record is allowed per name, got %d", set.Name, len(set.Values)))
+			}
+			if others := otherRecordTypes(typesByName[set.Name], "CNAME"); len(others) > 0 {
+				errs = append(errs, fmt.Errorf("%s CNAME: a CNAME record cannot coexist with other records of the same name (%s)", set.Name, strings.Join(others, ", ")))
+			}
+		case "MX", "SRV":
+			for _, value := range set.Values {
+				fields := strings.Fields(value)
+				target := fields[len(fields)-1]
+				if hasCNAME(target) {
+					errs = append(errs, fmt.Errorf("%s %s: target %s is a CNAME, which is not allowed", set.Name, set.Type, target))
+				}
+			}
+		case "TXT":
+			for _, value := range set.Values {
+				for _, length := range txtStringLengths(value) {
+					if length > maxTXTStringLength {
+						errs = append(errs, fmt.Errorf("%s TXT: string of %d bytes exceeds the limit of %d; split it into several quoted strings", set.Name, length, maxTXTStringLength))
+					}
+				}
+			}
+		}
+	}
+	return errs
+}
+
+// formatZoneValidationErrors combines validation errors into a single error,
+// or returns nil if there are none.
+func formatZoneValidationErrors(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = "* " + err.Error()
+	}
+	return fmt.Errorf("%d DNS record problem(s) found:\n\n%s", len(errs), strings.Join(messages, "\n"))
+}
+
+func otherRecordTypes(types []string, exclude string) []string {
+	var result []string
+	for _, current := range types {
+		if current != exclude {
+			result = append(result, current)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// txtStringLengths returns the decoded length in bytes of each quoted
+// character-string in a TXT value. A \DDD escape counts as one byte, as does
+// a backslash followed by any other character.
+func txtStringLengths(value string) []int {
+	var result []int
+	for i := 0; i < len(value); i++ {
+		if value[i] != '"' {
+			continue
+		}
+		length := 0
+		for i++; i < len(value) && value[i] != '"'; i++ {
+			if value[i] == '\\' && i+3 < len(value) && isDigits(value[i+1:i+4]) {
+				i += 3
+			} else if value[i] == '\\' {
+				i++
+			}
+			length++
+		}
+		result = append(result, length)
+	}
+	return result
+}
+
+func isDigits(value string) bool {
+	for i := 0; i < len(value); i++ {
+		if value[i] < '0' || value[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,87 @@
+package citrixitm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateZoneRecordSets(t *testing.T) {
+	longString := strings.Repeat("a", 256)
+	testData := []struct {
+		content        string
+		expectedErrors []string
+	}{
+		{
+			testZoneFile,
+			nil,
+		},
+		{
+			"@ CNAME www.example.net.\n",
+			[]string{"example.com. CNAME: a CNAME record is not allowed at the zone apex"},
+		},
+		{
+			"www CNAME foo.example.net.\nwww CNAME bar.example.net.\n",
+			[]string{"www.example.com. CNAME: only one CNAME record is allowed per name, got 2"},
+		},
+		{
+			"www CNAME foo.example.net.\nwww TXT foo\nwww A 192.0.2.1\n",
+			[]string{"www.example.com. CNAME: a CNAME record cannot coexist with other records of the same name (A, TXT)"},
+		},
+		{
+			"mail CNAME mx.example.net.\n@ MX 10 mail\n_sip._tcp SRV 0 0 5060 mail.example.com.\n",
+			[]string{
+				"_sip._tcp.example.com. SRV: target mail.example.com. is a CNAME",
+				"example.com. MX: target mail.example.com. is a CNAME",
+			},
+		},
+		{
+			"@ TXT \"" + longString + "\"\n@ TXT \"" + longString[1:] + "\" \"" + longString[1:] + "\"\n",
+			[]string{"example.com. TXT: string of 256 bytes exceeds the limit of 255"},
+		},
+	}
+	for _, current := range testData {
+		content := current.content
+		if !strings.HasPrefix(content, "$ORIGIN") {
+			content = "$ORIGIN example.com.\n$TTL 300\n" + content
+		}
+		sets, origin, err := parseZoneFile(content, "")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+		errs := validateZoneRecordSets(sets, origin)
+		if len(errs) != len(current.expectedErrors) {
+			t.Errorf("Unexpected errors validating %q: %v", current.content, errs)
+			continue
+		}
+		for i, expected := range current.expectedErrors {
+			if !strings.Contains(errs[i].Error(), expected) {
+				t.Error(unexpectedValueString("error", expected, errs[i].Error()))
+			}
+		}
+	}
+}
+
+func TestTXTStringLengths(t *testing.T) {
+	testData := []struct {
+		value    string
+		expected []int
+	}{
+		{`"foo"`, []int{3}},
+		{`"foo" "barbaz"`, []int{3, 6}},
+		{`"a\"b"`, []int{3}},
+		{`"\065\066"`, []int{2}},
+		{`""`, []int{0}},
+	}
+	for _, current := range testData {
+		got := txtStringLengths(current.value)
+		if len(got) != len(current.expected) {
+			t.Error(unexpectedValueString(current.value, current.expected, got))
+			continue
+		}
+		for i := range got {
+			if got[i] != current.expected[i] {
+				t.Error(unexpectedValueString(current.value, current.expected, got))
+			}
+		}
+	}
+}